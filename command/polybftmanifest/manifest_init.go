@@ -23,9 +23,18 @@ const (
 	validatorsFlag        = "validators"
 	validatorsPathFlag    = "validators-path"
 	validatorsPrefixFlag  = "validators-prefix"
+	predeployFlag         = "predeploy"
+
+	nativeTokenNameFlag     = "native-token-name"
+	nativeTokenSymbolFlag   = "native-token-symbol"
+	nativeTokenDecimalsFlag = "native-token-decimals"
+	nativeTokenMintableFlag = "native-token-mintable"
 
 	defaultValidatorPrefixPath = "test-chain-"
 	defaultManifestPath        = "./manifest.json"
+	defaultNativeTokenName     = "Polygon"
+	defaultNativeTokenSymbol   = "MATIC"
+	defaultNativeTokenDecimals = uint8(18)
 
 	nodeIDLength       = 53
 	ecdsaAddressLength = 42
@@ -49,8 +58,8 @@ func GetCommand() *cobra.Command {
 	return cmd
 }
 
-func runPreRun(_ *cobra.Command, _ []string) error {
-	return params.validateFlags()
+func runPreRun(cmd *cobra.Command, _ []string) error {
+	return params.validateFlags(cmd)
 }
 
 func setFlags(cmd *cobra.Command) {
@@ -89,11 +98,51 @@ func setFlags(cmd *cobra.Command) {
 		"the amount which will be pre-mined to all the validators",
 	)
 
-	cmd.Flags().StringVar(
-		&params.stakeRaw,
+	cmd.Flags().StringArrayVar(
+		&params.stakesRaw,
 		stakeFlag,
-		"",
-		"the amount which will be staked by all the validators",
+		[]string{},
+		fmt.Sprintf(
+			"the amount which will be staked by a validator (format: <address>[:<amount>], "+
+				"amount defaults to %s when omitted)",
+			command.DefaultStake,
+		),
+	)
+
+	cmd.Flags().StringArrayVar(
+		&params.predeploysRaw,
+		predeployFlag,
+		[]string{},
+		"a contract to predeploy into the genesis alloc "+
+			"(format: <artifact path>@<address>[:<constructor arg>[,<constructor arg>...]])",
+	)
+
+	cmd.Flags().StringVar(
+		&params.nativeTokenName,
+		nativeTokenNameFlag,
+		defaultNativeTokenName,
+		"the name of the native token",
+	)
+
+	cmd.Flags().StringVar(
+		&params.nativeTokenSymbol,
+		nativeTokenSymbolFlag,
+		defaultNativeTokenSymbol,
+		"the symbol of the native token",
+	)
+
+	cmd.Flags().Uint8Var(
+		&params.nativeTokenDecimals,
+		nativeTokenDecimalsFlag,
+		defaultNativeTokenDecimals,
+		"the number of decimals of the native token",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.nativeTokenMintable,
+		nativeTokenMintableFlag,
+		true,
+		"whether the native token can be minted on this chain, or is bridged in from the rootchain",
 	)
 
 	cmd.MarkFlagsMutuallyExclusive(validatorsFlag, validatorsPathFlag)
@@ -111,7 +160,11 @@ func runCommand(cmd *cobra.Command, _ []string) {
 		return
 	}
 
-	manifest := &polybft.Manifest{GenesisValidators: validators}
+	manifest := &polybft.Manifest{
+		GenesisValidators: validators,
+		Predeploys:        params.predeploys,
+		NativeTokenConfig: params.getNativeTokenConfig(),
+	}
 	if err = manifest.Save(params.manifestPath); err != nil {
 		outputter.SetError(fmt.Errorf("failed to save manifest file '%s': %w", params.manifestPath, err))
 
@@ -127,14 +180,19 @@ type manifestInitParams struct {
 	validatorsPrefixPath string
 	premineValidators    string
 	premineBalance       *big.Int
-	stakeRaw             string
-	stake                *big.Int
+	stakesRaw            []string
+	stakes               map[types.Address]*big.Int
 	validators           []string
+	predeploysRaw        []string
+	predeploys           []*polybft.PredeploySpec
+	nativeTokenName      string
+	nativeTokenSymbol    string
+	nativeTokenDecimals  uint8
+	nativeTokenMintable  bool
 }
 
-func (p *manifestInitParams) validateFlags() error {
+func (p *manifestInitParams) validateFlags(cmd *cobra.Command) error {
 	var (
-		stake          *big.Int
 		premineBalance *big.Int
 		err            error
 	)
@@ -143,37 +201,142 @@ func (p *manifestInitParams) validateFlags() error {
 		return fmt.Errorf("provided validators path '%s' doesn't exist", p.validatorsPath)
 	}
 
-	if premineBalance, err = types.ParseUint256orHex(&p.premineValidators); err != nil {
-		return fmt.Errorf("invalid premine validators balance provided '%s': %w", p.premineValidators, err)
+	if !p.nativeTokenMintable && cmd.Flags().Changed(premineValidatorsFlag) {
+		return fmt.Errorf("%s cannot be used with a non-mintable native token: "+
+			"validators cannot be premined on L2 for tokens bridged in from L1",
+			premineValidatorsFlag)
 	}
 
-	if p.stakeRaw != "" {
-		if stake, err = types.ParseUint256orHex(&p.stakeRaw); err != nil {
-			return fmt.Errorf("invalid stake amount provided '%s': %w", p.premineValidators, err)
+	premineBalance = big.NewInt(0)
+	if p.nativeTokenMintable {
+		if premineBalance, err = types.ParseUint256orHex(&p.premineValidators); err != nil {
+			return fmt.Errorf("invalid premine validators balance provided '%s': %w", p.premineValidators, err)
 		}
+	}
 
-		if stake.Cmp(premineBalance) > 0 {
-			return fmt.Errorf("provided stake is greater than premine balance (stake=%s premine balance=%s)",
-				stake.String(), premineBalance.String())
+	stakes, err := parseStakes(p.stakesRaw)
+	if err != nil {
+		return err
+	}
+
+	// on a mintable native token, stake is carved out of the premine balance; on a non-mintable
+	// token there is no premine, so stake instead comes from the rootchain deposit and is not
+	// bounded by it. Validators falling back to command.DefaultStake are bound-checked in
+	// assignStakes instead, since only there do we know which validators actually resolve to it.
+	if p.nativeTokenMintable {
+		for address, stake := range stakes {
+			if stake.Cmp(premineBalance) > 0 {
+				return fmt.Errorf("provided stake for validator %s is greater than premine balance "+
+					"(stake=%s premine balance=%s)", address, stake.String(), premineBalance.String())
+			}
 		}
 	}
 
+	predeploys, err := parsePredeploys(p.predeploysRaw)
+	if err != nil {
+		return err
+	}
+
 	p.premineBalance = premineBalance
-	p.stake = stake
+	p.stakes = stakes
+	p.predeploys = predeploys
 
 	return nil
 }
 
+// parseStakes parses the repeatable --stake flag values, each in the format
+// <address>[:<amount>], into a map keyed by validator address. The amount defaults to
+// command.DefaultStake when omitted.
+func parseStakes(raw []string) (map[types.Address]*big.Int, error) {
+	stakes := make(map[types.Address]*big.Int, len(raw))
+
+	for _, entry := range raw {
+		parts := strings.SplitN(entry, ":", 2)
+
+		if len(parts[0]) != ecdsaAddressLength {
+			return nil, fmt.Errorf("invalid stake address '%s'", parts[0])
+		}
+
+		amountRaw := command.DefaultStake
+		if len(parts) == 2 && parts[1] != "" {
+			amountRaw = parts[1]
+		}
+
+		amount, err := types.ParseUint256orHex(&amountRaw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid stake amount provided '%s': %w", amountRaw, err)
+		}
+
+		address := types.StringToAddress(parts[0])
+		if _, exists := stakes[address]; exists {
+			return nil, fmt.Errorf("duplicate stake entry for validator address '%s'", parts[0])
+		}
+
+		stakes[address] = amount
+	}
+
+	return stakes, nil
+}
+
+func (p *manifestInitParams) getNativeTokenConfig() *polybft.NativeTokenConfig {
+	return &polybft.NativeTokenConfig{
+		Name:     p.nativeTokenName,
+		Symbol:   p.nativeTokenSymbol,
+		Decimals: p.nativeTokenDecimals,
+		Mintable: p.nativeTokenMintable,
+	}
+}
+
+// parsePredeploys parses the repeatable --predeploy flag values, each in the format
+// <artifact path>@<address>[:<constructor arg>[,<constructor arg>...]]
+func parsePredeploys(raw []string) ([]*polybft.PredeploySpec, error) {
+	predeploys := make([]*polybft.PredeploySpec, len(raw))
+
+	for i, entry := range raw {
+		atParts := strings.SplitN(entry, "@", 2)
+		if len(atParts) != 2 {
+			return nil, fmt.Errorf("invalid predeploy '%s', expected format "+
+				"<artifact path>@<address>[:<constructor arg>,...]", entry)
+		}
+
+		artifactPath := atParts[0]
+
+		addressAndArgs := strings.SplitN(atParts[1], ":", 2)
+		if len(addressAndArgs[0]) != ecdsaAddressLength {
+			return nil, fmt.Errorf("invalid predeploy address '%s'", addressAndArgs[0])
+		}
+
+		var constructorArgs []string
+		if len(addressAndArgs) == 2 && addressAndArgs[1] != "" {
+			constructorArgs = strings.Split(addressAndArgs[1], ",")
+		}
+
+		predeploys[i] = &polybft.PredeploySpec{
+			Address:         types.StringToAddress(addressAndArgs[0]),
+			ArtifactPath:    artifactPath,
+			ConstructorArgs: constructorArgs,
+		}
+	}
+
+	return predeploys, nil
+}
+
 // getValidatorAccounts gathers validator accounts info either from CLI or from provided local storage
 func (p *manifestInitParams) getValidatorAccounts() ([]*polybft.Validator, error) {
-	stake := p.stake
-	// stake not provided => use validator balance as stake
-	if stake == nil {
-		stake = new(big.Int).Set(p.premineBalance)
+	// a non-mintable native token is bridged in from the rootchain, so validators cannot be
+	// premined on L2 - their stake comes from the rootchain deposit instead
+	balance := p.premineBalance
+	if !p.nativeTokenMintable {
+		balance = big.NewInt(0)
 	}
 
+	var (
+		validators []*polybft.Validator
+		err        error
+	)
+
 	if len(p.validators) > 0 {
-		validators := make([]*polybft.Validator, len(p.validators))
+		validators = make([]*polybft.Validator, len(p.validators))
 		for i, validator := range p.validators {
 			parts := strings.Split(validator, ":")
 
@@ -199,30 +362,73 @@ func (p *manifestInitParams) getValidatorAccounts() ([]*polybft.Validator, error
 				NodeID:  parts[0],
 				Address: types.StringToAddress(parts[1]),
 				BlsKey:  parts[2],
-				Balance: p.premineBalance,
-				Stake:   stake,
+				Balance: balance,
 			}
 		}
+	} else {
+		validatorsPath := p.validatorsPath
+		if validatorsPath == "" {
+			validatorsPath = path.Dir(p.manifestPath)
+		}
 
-		return validators, nil
+		if validators, err = genesis.ReadValidatorsByPrefix(validatorsPath, p.validatorsPrefixPath); err != nil {
+			return nil, err
+		}
+
+		for _, v := range validators {
+			v.Balance = balance
+		}
 	}
 
-	validatorsPath := p.validatorsPath
-	if validatorsPath == "" {
-		validatorsPath = path.Dir(p.manifestPath)
+	if err = p.assignStakes(validators); err != nil {
+		return nil, err
 	}
 
-	validators, err := genesis.ReadValidatorsByPrefix(validatorsPath, p.validatorsPrefixPath)
+	return validators, nil
+}
+
+// assignStakes resolves each validator's stake from the --stake flag, falling back to
+// command.DefaultStake, and rejects any address passed to --stake that isn't a known validator.
+// On a mintable native token, only validators that actually fall back to the default have it
+// bound-checked against the premine balance - an oversized default is harmless if every
+// validator already has an explicit --stake entry, so it's rejected only when it would be used.
+func (p *manifestInitParams) assignStakes(validators []*polybft.Validator) error {
+	defaultStakeRaw := command.DefaultStake
+
+	defaultStake, err := types.ParseUint256orHex(&defaultStakeRaw)
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("invalid default stake '%s': %w", command.DefaultStake, err)
+	}
+
+	unclaimed := make(map[types.Address]struct{}, len(p.stakes))
+	for address := range p.stakes {
+		unclaimed[address] = struct{}{}
 	}
 
 	for _, v := range validators {
-		v.Balance = p.premineBalance
-		v.Stake = stake
+		if stake, ok := p.stakes[v.Address]; ok {
+			v.Stake = stake
+			delete(unclaimed, v.Address)
+		} else {
+			if p.nativeTokenMintable && defaultStake.Cmp(p.premineBalance) > 0 {
+				return fmt.Errorf("default stake is greater than premine balance "+
+					"(stake=%s premine balance=%s)", defaultStake.String(), p.premineBalance.String())
+			}
+
+			v.Stake = new(big.Int).Set(defaultStake)
+		}
 	}
 
-	return validators, nil
+	if len(unclaimed) > 0 {
+		addresses := make([]string, 0, len(unclaimed))
+		for address := range unclaimed {
+			addresses = append(addresses, address.String())
+		}
+
+		return fmt.Errorf("provided stake for unknown validator address(es): %s", strings.Join(addresses, ", "))
+	}
+
+	return nil
 }
 
 func (p *manifestInitParams) getResult() command.CommandResult {
@@ -0,0 +1,39 @@
+package polybftmanifest
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const predeployAddr = "0xcccccccccccccccccccccccccccccccccccccccc"
+
+func TestParsePredeploys(t *testing.T) {
+	t.Run("valid predeploy with constructor args", func(t *testing.T) {
+		predeploys, err := parsePredeploys([]string{"./artifact.json@" + predeployAddr + ":1,2"})
+		require.NoError(t, err)
+		require.Len(t, predeploys, 1)
+		assert.Equal(t, "./artifact.json", predeploys[0].ArtifactPath)
+		assert.Equal(t, types.StringToAddress(predeployAddr), predeploys[0].Address)
+		assert.Equal(t, []string{"1", "2"}, predeploys[0].ConstructorArgs)
+	})
+
+	t.Run("valid predeploy without constructor args", func(t *testing.T) {
+		predeploys, err := parsePredeploys([]string{"./artifact.json@" + predeployAddr})
+		require.NoError(t, err)
+		require.Len(t, predeploys, 1)
+		assert.Empty(t, predeploys[0].ConstructorArgs)
+	})
+
+	t.Run("missing @ separator is rejected", func(t *testing.T) {
+		_, err := parsePredeploys([]string{"./artifact.json" + predeployAddr})
+		require.Error(t, err)
+	})
+
+	t.Run("malformed address is rejected", func(t *testing.T) {
+		_, err := parsePredeploys([]string{"./artifact.json@0xnotanaddress"})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,108 @@
+package polybftmanifest
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	addrA = "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	addrB = "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+)
+
+func TestParseStakes(t *testing.T) {
+	t.Run("explicit amount", func(t *testing.T) {
+		stakes, err := parseStakes([]string{addrA + ":50"})
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(50), stakes[types.StringToAddress(addrA)])
+	})
+
+	t.Run("omitted amount falls back to the default stake", func(t *testing.T) {
+		stakes, err := parseStakes([]string{addrA})
+		require.NoError(t, err)
+
+		defaultStakeRaw := command.DefaultStake
+		defaultStake, err := types.ParseUint256orHex(&defaultStakeRaw)
+		require.NoError(t, err)
+
+		assert.Equal(t, defaultStake, stakes[types.StringToAddress(addrA)])
+	})
+
+	t.Run("duplicate address is rejected", func(t *testing.T) {
+		_, err := parseStakes([]string{addrA + ":50", addrA + ":60"})
+		require.Error(t, err)
+	})
+
+	t.Run("malformed address is rejected", func(t *testing.T) {
+		_, err := parseStakes([]string{"0xnotanaddress:50"})
+		require.Error(t, err)
+	})
+
+	t.Run("malformed amount is rejected", func(t *testing.T) {
+		_, err := parseStakes([]string{addrA + ":not-a-number"})
+		require.Error(t, err)
+	})
+}
+
+func TestAssignStakes(t *testing.T) {
+	t.Run("explicit stake is used over the default", func(t *testing.T) {
+		p := &manifestInitParams{
+			premineBalance:      big.NewInt(1000),
+			nativeTokenMintable: true,
+			stakes:              map[types.Address]*big.Int{types.StringToAddress(addrA): big.NewInt(50)},
+		}
+		validators := []*polybft.Validator{{Address: types.StringToAddress(addrA)}}
+
+		require.NoError(t, p.assignStakes(validators))
+		assert.Equal(t, big.NewInt(50), validators[0].Stake)
+	})
+
+	t.Run("unknown staked address is rejected", func(t *testing.T) {
+		p := &manifestInitParams{
+			premineBalance:      big.NewInt(1000),
+			nativeTokenMintable: true,
+			stakes:              map[types.Address]*big.Int{types.StringToAddress(addrB): big.NewInt(50)},
+		}
+		validators := []*polybft.Validator{{Address: types.StringToAddress(addrA)}}
+
+		require.Error(t, p.assignStakes(validators))
+	})
+
+	// A default stake larger than the premine balance must only fail validators that actually
+	// fall back to it - one that already has every validator covered by an explicit --stake
+	// should never see the default, let alone be rejected because of it.
+	t.Run("oversized default is ignored when every validator has an explicit stake", func(t *testing.T) {
+		p := &manifestInitParams{
+			premineBalance:      big.NewInt(1),
+			nativeTokenMintable: true,
+			stakes: map[types.Address]*big.Int{
+				types.StringToAddress(addrA): big.NewInt(1),
+				types.StringToAddress(addrB): big.NewInt(1),
+			},
+		}
+		validators := []*polybft.Validator{
+			{Address: types.StringToAddress(addrA)},
+			{Address: types.StringToAddress(addrB)},
+		}
+
+		require.NoError(t, p.assignStakes(validators))
+	})
+
+	t.Run("oversized default is rejected for a validator that falls back to it", func(t *testing.T) {
+		p := &manifestInitParams{
+			premineBalance:      big.NewInt(1),
+			nativeTokenMintable: true,
+			stakes:              map[types.Address]*big.Int{},
+		}
+		validators := []*polybft.Validator{{Address: types.StringToAddress(addrA)}}
+
+		require.Error(t, p.assignStakes(validators))
+	})
+}
+
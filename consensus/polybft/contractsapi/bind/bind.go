@@ -0,0 +1,88 @@
+// Package bind provides the runtime support for the contract bindings produced by
+// contractsapi/bindings-gen: a minimal transport abstraction so generated Call/Transact/
+// Filter/Watch wrappers can be pointed at a real JSON-RPC client or, in tests, at a
+// simulated backend, mirroring go-ethereum's accounts/abi/bind package.
+package bind
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/abi"
+)
+
+// ContractBackend is the set of transport operations a generated binding needs.
+// Production code satisfies it with a JSON-RPC client; tests can satisfy it with an
+// in-memory implementation instead of standing up a node.
+type ContractBackend interface {
+	// Call executes an eth_call against the given address with abi-encoded input
+	Call(ctx context.Context, to types.Address, input []byte, blockNumber ethgo.BlockNumber) ([]byte, error)
+
+	// SendTransaction signs and broadcasts a transaction, returning the pending transaction
+	SendTransaction(ctx context.Context, tx *types.Transaction, opts *TransactOpts) (*types.Transaction, error)
+
+	// GetLogs returns the logs currently matching filter, used by generated FilterXxx methods
+	GetLogs(ctx context.Context, filter *ethgo.LogFilter) ([]*ethgo.Log, error)
+
+	// SubscribeLogs streams logs matching filter to sink, used by generated WatchXxx methods
+	SubscribeLogs(ctx context.Context, filter *ethgo.LogFilter, sink chan<- *ethgo.Log) (ethgo.Subscription, error)
+}
+
+// CallOpts customizes a generated Call (eth_call) invocation
+type CallOpts struct {
+	Context     context.Context
+	BlockNumber ethgo.BlockNumber
+}
+
+// TransactOpts customizes a generated Transact invocation
+type TransactOpts struct {
+	Context  context.Context
+	From     types.Address
+	Signer   crypto.TxSigner
+	Nonce    uint64
+	Gas      uint64
+	GasPrice *big.Int
+}
+
+// Contract binds a deployed contract address and ABI to a ContractBackend. Generated
+// per-contract wrapper types embed it to get Call/Transact/Filter/Watch helpers.
+type Contract struct {
+	Address types.Address
+	Abi     *abi.ABI
+	Backend ContractBackend
+}
+
+// NewContract returns a Contract bound to address, ready for generated wrappers to use
+func NewContract(address types.Address, contractAbi *abi.ABI, backend ContractBackend) *Contract {
+	return &Contract{Address: address, Abi: contractAbi, Backend: backend}
+}
+
+// CallOptsOrDefault returns opts, or a CallOpts requesting the latest block if opts is nil
+func CallOptsOrDefault(opts *CallOpts) *CallOpts {
+	if opts != nil {
+		return opts
+	}
+
+	return &CallOpts{BlockNumber: ethgo.Latest}
+}
+
+// ContextOrBackground returns c.Context if set, otherwise context.Background()
+func (c *CallOpts) ContextOrBackground() context.Context {
+	if c != nil && c.Context != nil {
+		return c.Context
+	}
+
+	return context.Background()
+}
+
+// ContextOrBackground returns t.Context if set, otherwise context.Background()
+func (t *TransactOpts) ContextOrBackground() context.Context {
+	if t != nil && t.Context != nil {
+		return t.Context
+	}
+
+	return context.Background()
+}
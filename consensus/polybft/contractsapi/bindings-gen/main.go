@@ -2,6 +2,8 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
+	"flag"
 	"fmt"
 	"go/format"
 	"io/ioutil"
@@ -23,63 +25,80 @@ const (
 	functionNameFormat     = "%sFunction"
 )
 
+// artifactConfig selects, for a single contract artifact, which methods and events
+// should get generated Encode/Decode/Call/Transact/Filter/Watch bindings
+type artifactConfig struct {
+	Name      string   `json:"name"`
+	Functions []string `json:"functions"`
+	Events    []string `json:"events"`
+}
+
+// generatorConfig is the top level shape of the file passed via -config
+type generatorConfig struct {
+	Artifacts []artifactConfig `json:"artifacts"`
+}
+
+// artifactRegistry maps a config entry's "name" to the compiled artifact it binds.
+// New contracts need an entry here before they can be listed in the config file - this is a
+// stopgap, not the general-purpose "wire up a contract from the config alone" goal, since
+// artifacts are Go vars baked in at compile time rather than loaded from disk by path. Making
+// this config-only would mean resolving an artifact from its path at generation time instead.
+var artifactRegistry = map[string]*artifact.Artifact{
+	"StateReceiver":     gensc.StateReceiver,
+	"ChildValidatorSet": gensc.ChildValidatorSet,
+	"StateSender":       gensc.StateSender,
+	"CheckpointManager": gensc.CheckpointManager,
+}
+
+func loadConfig(path string) (*generatorConfig, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read generator config '%s': %w", path, err)
+	}
+
+	var cfg generatorConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse generator config '%s': %w", path, err)
+	}
+
+	return &cfg, nil
+}
+
 func main() {
-	cases := []struct {
-		contractName string
-		artifact     *artifact.Artifact
-		functions    []string
-		events       []string
-	}{
-		{
-			"StateReceiver",
-			gensc.StateReceiver,
-			[]string{
-				"commit",
-				"execute",
-			},
-			[]string{
-				"StateSyncResult",
-				"NewCommitment",
-			},
-		},
-		{
-			"ChildValidatorSet",
-			gensc.ChildValidatorSet,
-			[]string{
-				"commitEpoch",
-			},
-			[]string{},
-		},
-		{
-			"StateSender",
-			gensc.StateSender,
-			[]string{
-				"syncState",
-			},
-			[]string{
-				"StateSynced",
-			},
-		},
-		{
-			"CheckpointManager",
-			gensc.CheckpointManager,
-			[]string{
-				"submit",
-			},
-			[]string{},
-		},
+	configPath := flag.String(
+		"config",
+		"./consensus/polybft/contractsapi/bindings-gen/artifacts.json",
+		"path to the config file listing the artifacts and the methods/events to bind",
+	)
+	flag.Parse()
+
+	cfg, err := loadConfig(*configPath)
+	if err != nil {
+		panic(err)
 	}
 
 	rr := render{}
 	res := []string{}
 
-	for _, c := range cases {
-		for _, method := range c.functions {
-			res = append(res, rr.GenMethod(c.contractName, c.artifact.Abi.Methods[method]))
+	for _, c := range cfg.Artifacts {
+		contractArtifact, ok := artifactRegistry[c.Name]
+		if !ok {
+			panic(fmt.Sprintf("BUG: unknown artifact %q, register it in artifactRegistry", c.Name))
 		}
 
-		for _, event := range c.events {
-			res = append(res, rr.GenEvent(c.contractName, c.artifact.Abi.Events[event]))
+		res = append(res, rr.GenContractBinding(c.Name))
+
+		for _, method := range c.Functions {
+			abiMethod := contractArtifact.Abi.Methods[method]
+			res = append(res, rr.GenMethod(c.Name, abiMethod))
+			res = append(res, rr.GenCall(c.Name, abiMethod))
+			res = append(res, rr.GenTransact(c.Name, abiMethod))
+		}
+
+		for _, event := range c.Events {
+			abiEvent := contractArtifact.Abi.Events[event]
+			res = append(res, rr.GenEvent(c.Name, abiEvent))
+			res = append(res, rr.GenFilterAndWatch(c.Name, abiEvent))
 		}
 	}
 
@@ -87,8 +106,10 @@ func main() {
 package contractsapi
 
 import (
+	"context"
 	"math/big"
 
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi/bind"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/umbracle/ethgo/abi"
 	"github.com/umbracle/ethgo"
@@ -193,7 +214,7 @@ func genAbiFuncsForNestedType(name string) string {
 	tmpl := `func ({{.Sig}} *{{.TName}}) EncodeAbi() ([]byte, error) {
 		return {{.Name}}ABIType.Encode({{.Sig}})
 	}
-	
+
 	func ({{.Sig}} *{{.TName}}) DecodeAbi(buf []byte) error {
 		return decodeStruct({{.Name}}ABIType, buf, &{{.Sig}})
 	}`
@@ -282,6 +303,146 @@ func ({{.Sig}} *{{.TName}}) DecodeAbi(buf []byte) error {
 	return renderTmpl(tmplStr, inputs)
 }
 
+// GenContractBinding emits the per-contract wrapper type that Call/Transact/Filter/Watch
+// methods are generated onto, binding a deployed address and the contract's ABI to a bind.ContractBackend.
+func (r *render) GenContractBinding(contractName string) string {
+	tmplStr := `
+type {{.Name}} struct {
+	*bind.Contract
+}
+
+func New{{.Name}}(address types.Address, backend bind.ContractBackend) *{{.Name}} {
+	return &{{.Name}}{bind.NewContract(address, {{.Name}}Contract.Abi, backend)}
+}`
+
+	return renderTmpl(tmplStr, map[string]interface{}{"Name": contractName})
+}
+
+// GenCall emits an eth_call wrapper for method, analogous to go-ethereum abigen's Call methods.
+// It returns the raw abi-decoded output (a map keyed by output name) since the generator does
+// not currently synthesize typed return structs for method outputs.
+func (r *render) GenCall(contractName string, method *abi.Method) string {
+	methodName := fmt.Sprintf(functionNameFormat, method.Name)
+
+	tmplStr := `
+func (c *{{.ContractName}}) {{.MethodTitle}}(opts *bind.CallOpts, args *{{.ArgsType}}) (map[string]interface{}, error) {
+	input, err := args.EncodeAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	opts = bind.CallOptsOrDefault(opts)
+
+	raw, err := c.Backend.Call(opts.ContextOrBackground(), c.Address, input, opts.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Abi.Methods["{{.MethodName}}"].Decode(raw)
+}`
+
+	return renderTmpl(tmplStr, map[string]interface{}{
+		"ContractName": contractName,
+		"MethodTitle":  strings.Title(method.Name),
+		"MethodName":   method.Name,
+		"ArgsType":     strings.Title(methodName),
+	})
+}
+
+// GenTransact emits a state-changing wrapper for method, analogous to go-ethereum abigen's
+// Transact methods. It returns the broadcast *types.Transaction.
+func (r *render) GenTransact(contractName string, method *abi.Method) string {
+	methodName := fmt.Sprintf(functionNameFormat, method.Name)
+
+	tmplStr := `
+func (c *{{.ContractName}}) {{.MethodTitle}}Transact(opts *bind.TransactOpts, args *{{.ArgsType}}) (*types.Transaction, error) {
+	input, err := args.EncodeAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &types.Transaction{
+		From:     opts.From,
+		To:       &c.Address,
+		Input:    input,
+		Gas:      opts.Gas,
+		Nonce:    opts.Nonce,
+		GasPrice: opts.GasPrice,
+	}
+
+	return c.Backend.SendTransaction(opts.ContextOrBackground(), tx, opts)
+}`
+
+	return renderTmpl(tmplStr, map[string]interface{}{
+		"ContractName": contractName,
+		"MethodTitle":  strings.Title(method.Name),
+		"ArgsType":     strings.Title(methodName),
+	})
+}
+
+// GenFilterAndWatch emits FilterXxx (one-shot eth_getLogs) and WatchXxx (log subscription)
+// wrappers for event, analogous to go-ethereum abigen's FilterXxx/WatchXxx pair.
+func (r *render) GenFilterAndWatch(contractName string, event *abi.Event) string {
+	eventName := fmt.Sprintf(eventNameFormat, event.Name)
+
+	tmplStr := `
+func (c *{{.ContractName}}) Filter{{.EventTitle}}(opts *bind.CallOpts) ([]*{{.EventType}}, error) {
+	opts = bind.CallOptsOrDefault(opts)
+	topic := {{.ContractName}}.Abi.Events["{{.EventName}}"].ID()
+	topics := [][]*ethgo.Hash{ {&topic} }
+	filter := &ethgo.LogFilter{Address: []ethgo.Address{ethgo.Address(c.Address)}, Topics: topics}
+
+	logs, err := c.Backend.GetLogs(opts.ContextOrBackground(), filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*{{.EventType}}, 0, len(logs))
+
+	for _, log := range logs {
+		obj := &{{.EventType}}{}
+		if err := obj.ParseLog(log); err != nil {
+			continue
+		}
+
+		result = append(result, obj)
+	}
+
+	return result, nil
+}
+
+func (c *{{.ContractName}}) Watch{{.EventTitle}}(ctx context.Context, sink chan<- *{{.EventType}}) (ethgo.Subscription, error) {
+	topic := {{.ContractName}}.Abi.Events["{{.EventName}}"].ID()
+	topics := [][]*ethgo.Hash{ {&topic} }
+	filter := &ethgo.LogFilter{Address: []ethgo.Address{ethgo.Address(c.Address)}, Topics: topics}
+
+	logs := make(chan *ethgo.Log)
+
+	sub, err := c.Backend.SubscribeLogs(ctx, filter, logs)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		for log := range logs {
+			obj := &{{.EventType}}{}
+			if err := obj.ParseLog(log); err == nil {
+				sink <- obj
+			}
+		}
+	}()
+
+	return sub, nil
+}`
+
+	return renderTmpl(tmplStr, map[string]interface{}{
+		"ContractName": contractName,
+		"EventTitle":   strings.Title(event.Name),
+		"EventType":    strings.Title(eventName),
+		"EventName":    event.Name,
+	})
+}
+
 func renderTmpl(tmplStr string, inputs map[string]interface{}) string {
 	tmpl, err := template.New("name").Parse(tmplStr)
 	if err != nil {
@@ -306,4 +467,4 @@ func encodeFuncTuple(t *abi.Type) string {
 	str = strings.TrimSuffix(str, ")")
 
 	return str
-}
\ No newline at end of file
+}
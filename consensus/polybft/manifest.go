@@ -0,0 +1,62 @@
+package polybft
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// Validator holds genesis-time info about a polybft validator
+type Validator struct {
+	NodeID  string        `json:"nodeID"`
+	Address types.Address `json:"address"`
+	BlsKey  string        `json:"blsKey"`
+	Balance *big.Int      `json:"balance"`
+	Stake   *big.Int      `json:"stake"`
+}
+
+// Manifest holds the genesis-time configuration for a polybft chain, written by the
+// `polygon-edge manifest` command and consumed when building the genesis file.
+type Manifest struct {
+	GenesisValidators []*Validator       `json:"genesisValidators"`
+	Predeploys        []*PredeploySpec   `json:"predeploys,omitempty"`
+	NativeTokenConfig *NativeTokenConfig `json:"nativeTokenConfig,omitempty"`
+}
+
+// Save marshals the manifest as indented JSON and writes it to path
+func (m *Manifest) Save(path string) error {
+	data, err := json.MarshalIndent(m, "", "    ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal manifest to JSON: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("failed to save manifest file '%s': %w", path, err)
+	}
+
+	return nil
+}
+
+// LoadManifest reads and unmarshals the manifest file at path
+func LoadManifest(path string) (*Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file '%s': %w", path, err)
+	}
+
+	manifest := &Manifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal manifest file '%s': %w", path, err)
+	}
+
+	return manifest, nil
+}
+
+// GenesisAlloc resolves the manifest's predeploys into genesis alloc entries, running each
+// artifact's constructor through run (see ApplyPredeploys for details)
+func (m *Manifest) GenesisAlloc(run ConstructorRunner) (map[types.Address]*GenesisAllocEntry, error) {
+	return ApplyPredeploys(m.Predeploys, run)
+}
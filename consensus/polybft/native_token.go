@@ -0,0 +1,11 @@
+package polybft
+
+// NativeTokenConfig describes the chain's native token. When Mintable is false, the token is
+// bridged in from the rootchain rather than minted on L2, so validators cannot be premined —
+// their stake is backed by the rootchain deposit instead.
+type NativeTokenConfig struct {
+	Name     string `json:"name"`
+	Symbol   string `json:"symbol"`
+	Decimals uint8  `json:"decimals"`
+	Mintable bool   `json:"isMintable"`
+}
@@ -0,0 +1,81 @@
+package polybft
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// PredeploySpec describes a contract that should be baked into the genesis alloc by running
+// its constructor against a scratch EVM and snapshotting the resulting storage, instead of
+// hand-crafting the alloc entry. This lets operators launch chains with custom system
+// contracts (bridges, oracles, ERC20s) directly from the manifest.
+type PredeploySpec struct {
+	// Address is the address the predeployed contract will live at in genesis
+	Address types.Address `json:"address"`
+
+	// ArtifactPath is the path to the compiled contract artifact (abi + bytecode json) on disk
+	ArtifactPath string `json:"artifactPath"`
+
+	// ConstructorArgs are RLP/ABI encoded and passed to the constructor when the scratch EVM runs it
+	ConstructorArgs []string `json:"constructorArgs,omitempty"`
+
+	// StorageOverrides force specific storage slots after construction, applied on top of
+	// whatever the constructor itself wrote
+	StorageOverrides map[types.Hash]types.Hash `json:"storageOverrides,omitempty"`
+
+	// Balance is the starting native token balance credited to the predeployed contract
+	Balance *big.Int `json:"balance,omitempty"`
+}
+
+// GenesisAllocEntry is the account state baked into the genesis alloc for a predeployed contract
+type GenesisAllocEntry struct {
+	Balance *big.Int
+	Storage map[types.Hash]types.Hash
+}
+
+// ConstructorRunner executes a PredeploySpec's artifact constructor (with ConstructorArgs) in a
+// scratch EVM and returns the storage it leaves behind. It is injected into ApplyPredeploys
+// rather than called directly, since the EVM/state execution it requires lives in the genesis
+// builder, not in this package.
+type ConstructorRunner func(spec *PredeploySpec) (map[types.Hash]types.Hash, error)
+
+// ApplyPredeploys turns predeploys into genesis alloc entries: for each spec, run executes the
+// artifact constructor in a scratch EVM to get its initial storage (skipped if run is nil or
+// the spec carries no ArtifactPath, e.g. one with only StorageOverrides), then StorageOverrides are
+// layered on top so operators can force specific slots regardless of what the constructor wrote.
+func ApplyPredeploys(predeploys []*PredeploySpec, run ConstructorRunner) (map[types.Address]*GenesisAllocEntry, error) {
+	alloc := make(map[types.Address]*GenesisAllocEntry, len(predeploys))
+
+	for _, spec := range predeploys {
+		storage := make(map[types.Hash]types.Hash)
+
+		if run != nil && spec.ArtifactPath != "" {
+			constructed, err := run(spec)
+			if err != nil {
+				return nil, fmt.Errorf("failed to run constructor for predeploy at '%s': %w", spec.Address, err)
+			}
+
+			for slot, value := range constructed {
+				storage[slot] = value
+			}
+		}
+
+		for slot, value := range spec.StorageOverrides {
+			storage[slot] = value
+		}
+
+		balance := spec.Balance
+		if balance == nil {
+			balance = big.NewInt(0)
+		}
+
+		alloc[spec.Address] = &GenesisAllocEntry{
+			Balance: balance,
+			Storage: storage,
+		}
+	}
+
+	return alloc, nil
+}
@@ -0,0 +1,57 @@
+package polybft
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyPredeploys(t *testing.T) {
+	addr := types.StringToAddress("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	slot := types.StringToHash("0x01")
+	constructedValue := types.StringToHash("0x02")
+	overrideValue := types.StringToHash("0x03")
+
+	t.Run("no artifact path skips the constructor runner", func(t *testing.T) {
+		spec := &PredeploySpec{Address: addr}
+
+		alloc, err := ApplyPredeploys([]*PredeploySpec{spec}, func(*PredeploySpec) (map[types.Hash]types.Hash, error) {
+			t.Fatal("run should not be called for a spec without an artifact path")
+
+			return nil, nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(0), alloc[addr].Balance)
+		assert.Empty(t, alloc[addr].Storage)
+	})
+
+	t.Run("storage overrides are layered on top of the constructor's storage", func(t *testing.T) {
+		spec := &PredeploySpec{
+			Address:          addr,
+			ArtifactPath:     "./artifact.json",
+			StorageOverrides: map[types.Hash]types.Hash{slot: overrideValue},
+		}
+
+		alloc, err := ApplyPredeploys([]*PredeploySpec{spec}, func(*PredeploySpec) (map[types.Hash]types.Hash, error) {
+			return map[types.Hash]types.Hash{slot: constructedValue}, nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, overrideValue, alloc[addr].Storage[slot])
+	})
+
+	t.Run("constructor error is wrapped and surfaced", func(t *testing.T) {
+		spec := &PredeploySpec{Address: addr, ArtifactPath: "./artifact.json"}
+
+		_, err := ApplyPredeploys([]*PredeploySpec{spec}, func(*PredeploySpec) (map[types.Hash]types.Hash, error) {
+			return nil, errors.New("constructor reverted")
+		})
+
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,68 @@
+package crypto
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// TxSigner is a utility interface used to recover data from a transaction
+type TxSigner interface {
+	// Hash returns the hash of the transaction
+	Hash(tx *types.Transaction) types.Hash
+
+	// Sender returns the sender of the transaction
+	Sender(tx *types.Transaction) (types.Address, error)
+
+	// SignTx signs the transaction using the passed in private key
+	SignTx(tx *types.Transaction, privateKey *ecdsa.PrivateKey) (*types.Transaction, error)
+}
+
+// NewSigner picks the appropriate TxSigner for the given fork configuration and chain ID.
+// Each typed signer only knows how to hash/sign/recover its own tx.Type and delegates anything
+// else to the signer that was active before its fork activated (AccessListSigner falls back to
+// the pre-Berlin signer, DynamicFeeSigner falls back to AccessListSigner, and so on), since a tx
+// of an older type stays valid after a later fork activates - it doesn't stop verifying.
+func NewSigner(forks chain.ForksInTime, chainID uint64) TxSigner {
+	var signer TxSigner = &FrontierSigner{}
+
+	if forks.EIP155 {
+		signer = NewEIP155Signer(chainID)
+	}
+
+	if forks.Berlin {
+		signer = NewAccessListSigner(chainID, signer)
+	}
+
+	if forks.London {
+		signer = NewDynamicFeeSigner(chainID, signer)
+	}
+
+	return signer
+}
+
+// typedTxHash computes the EIP-2718 typed transaction hash: keccak256(txType || rlp(payload))
+func typedTxHash(txType types.TxType, payload []byte) types.Hash {
+	buf := make([]byte, 0, len(payload)+1)
+	buf = append(buf, byte(txType))
+	buf = append(buf, payload...)
+
+	return types.BytesToHash(Keccak256(buf))
+}
+
+// validateTypedParity rejects legacy (27/28-based) v values, which are not valid for typed transactions
+func validateTypedParity(v *big.Int) (byte, error) {
+	if v == nil || !v.IsUint64() {
+		return 0, fmt.Errorf("invalid parity value for typed transaction")
+	}
+
+	parity := v.Uint64()
+	if parity != 0 && parity != 1 {
+		return 0, fmt.Errorf("invalid parity value %d for typed transaction, legacy v values are not allowed", parity)
+	}
+
+	return byte(parity), nil
+}
@@ -0,0 +1,100 @@
+package crypto
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewSigner_FallsBackForOlderTxTypes makes sure that once a later fork activates, the
+// signer it brings in still recovers senders for every transaction type that was valid before
+// it, rather than rejecting anything that isn't its own type.
+func TestNewSigner_FallsBackForOlderTxTypes(t *testing.T) {
+	key, err := GenerateKey()
+	require.NoError(t, err)
+
+	frontierSigner := &FrontierSigner{}
+
+	legacyTx := &types.Transaction{
+		Type:     types.LegacyTx,
+		Nonce:    0,
+		GasPrice: big.NewInt(1),
+		Gas:      21000,
+		Value:    big.NewInt(0),
+	}
+
+	signedLegacy, err := frontierSigner.SignTx(legacyTx, key)
+	require.NoError(t, err)
+
+	directLegacySender, err := frontierSigner.Sender(signedLegacy)
+	require.NoError(t, err)
+
+	accessListTx := &types.Transaction{
+		Type:       types.AccessListTxType,
+		Nonce:      1,
+		GasPrice:   big.NewInt(1),
+		Gas:        21000,
+		Value:      big.NewInt(0),
+		AccessList: types.AccessList{},
+	}
+
+	berlinSigner := NewSigner(chain.ForksInTime{Berlin: true}, 100)
+
+	signedAccessList, err := berlinSigner.SignTx(accessListTx, key)
+	require.NoError(t, err)
+
+	directAccessListSender, err := berlinSigner.Sender(signedAccessList)
+	require.NoError(t, err)
+
+	// Once London is active, its signer must still recover senders for transaction types that
+	// predate it instead of rejecting them outright.
+	londonSigner := NewSigner(chain.ForksInTime{Berlin: true, London: true}, 100)
+
+	recoveredFromLegacy, err := londonSigner.Sender(signedLegacy)
+	require.NoError(t, err)
+	assert.Equal(t, directLegacySender, recoveredFromLegacy)
+
+	recoveredFromAccessList, err := londonSigner.Sender(signedAccessList)
+	require.NoError(t, err)
+	assert.Equal(t, directAccessListSender, recoveredFromAccessList)
+
+	dynamicFeeTx := &types.Transaction{
+		Type:      types.DynamicFeeTxType,
+		Nonce:     2,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(2),
+		Gas:       21000,
+		Value:     big.NewInt(0),
+	}
+
+	signedDynamicFee, err := londonSigner.SignTx(dynamicFeeTx, key)
+	require.NoError(t, err)
+
+	recoveredFromDynamicFee, err := londonSigner.Sender(signedDynamicFee)
+	require.NoError(t, err)
+	assert.Equal(t, directLegacySender, recoveredFromDynamicFee)
+}
+
+// TestDynamicFeeSigner_RejectsLegacyParity makes sure a legacy 27/28 v value is never accepted
+// as a valid parity for a typed transaction.
+func TestDynamicFeeSigner_RejectsLegacyParity(t *testing.T) {
+	signer := NewDynamicFeeSigner(100, &FrontierSigner{})
+
+	tx := &types.Transaction{
+		Type:      types.DynamicFeeTxType,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(2),
+		Gas:       21000,
+		Value:     big.NewInt(0),
+		V:         big.NewInt(27),
+		R:         big.NewInt(1),
+		S:         big.NewInt(1),
+	}
+
+	_, err := signer.Sender(tx)
+	require.Error(t, err)
+}
@@ -0,0 +1,106 @@
+package crypto
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// AccessListSigner implements the EIP-2930 typed transaction signature scheme.
+// It is identical to EIP155Signer except the signed payload is the typed
+// envelope (type byte 0x01 + RLP of [chainId, nonce, gasPrice, gasLimit, to,
+// value, data, accessList]) and the recovery id is encoded directly as 0/1
+// instead of being folded into chainID*2+35+parity. Anything other than an
+// access-list transaction is delegated to fallback, the signer that was active
+// before Berlin, since those tx types remain valid after Berlin activates.
+type AccessListSigner struct {
+	chainID  uint64
+	fallback TxSigner
+}
+
+// NewAccessListSigner returns a new AccessListSigner object, delegating anything that isn't
+// an access-list transaction to fallback
+func NewAccessListSigner(chainID uint64, fallback TxSigner) *AccessListSigner {
+	return &AccessListSigner{chainID: chainID, fallback: fallback}
+}
+
+// Hash returns the keccak256 hash of the EIP-2718 typed payload, used both for signing and sender recovery
+func (e *AccessListSigner) Hash(tx *types.Transaction) types.Hash {
+	if tx.Type != types.AccessListTxType {
+		return e.fallback.Hash(tx)
+	}
+
+	a := signerPool.Get()
+	defer signerPool.Put(a)
+
+	vv := a.NewArray()
+	vv.Set(a.NewUint(e.chainID))
+	vv.Set(a.NewUint(tx.Nonce))
+	vv.Set(a.NewBigInt(tx.GasPrice))
+	vv.Set(a.NewUint(tx.Gas))
+
+	if tx.To != nil {
+		vv.Set(a.NewBytes((*tx.To).Bytes()))
+	} else {
+		vv.Set(a.NewBytes(nil))
+	}
+
+	vv.Set(a.NewBigInt(tx.Value))
+	vv.Set(a.NewBytes(tx.Input))
+	vv.Set(tx.AccessList.MarshalRLPWith(a))
+
+	return typedTxHash(types.AccessListTxType, vv.MarshalTo(nil))
+}
+
+// Sender returns the sender of the transaction
+func (e *AccessListSigner) Sender(tx *types.Transaction) (types.Address, error) {
+	if tx.Type != types.AccessListTxType {
+		return e.fallback.Sender(tx)
+	}
+
+	parity, err := validateTypedParity(tx.V)
+	if err != nil {
+		return types.Address{}, err
+	}
+
+	sig, err := encodeSignature(tx.R, tx.S, parity)
+	if err != nil {
+		return types.Address{}, err
+	}
+
+	pub, err := Ecrecover(e.Hash(tx).Bytes(), sig)
+	if err != nil {
+		return types.Address{}, err
+	}
+
+	buf := Keccak256(pub[1:])[12:]
+
+	return types.BytesToAddress(buf), nil
+}
+
+// SignTx signs the transaction using the passed in private key. tx.Type selects the
+// signature scheme; anything other than an access-list transaction is delegated to fallback.
+func (e *AccessListSigner) SignTx(
+	tx *types.Transaction,
+	privateKey *ecdsa.PrivateKey,
+) (*types.Transaction, error) {
+	if tx.Type != types.AccessListTxType {
+		return e.fallback.SignTx(tx, privateKey)
+	}
+
+	tx = tx.Copy()
+
+	h := e.Hash(tx)
+
+	sig, err := Sign(privateKey, h[:])
+	if err != nil {
+		return nil, err
+	}
+
+	tx.R = new(big.Int).SetBytes(sig[:32])
+	tx.S = new(big.Int).SetBytes(sig[32:64])
+	tx.V = new(big.Int).SetBytes([]byte{sig[64]})
+
+	return tx, nil
+}
@@ -0,0 +1,105 @@
+package crypto
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// DynamicFeeSigner implements the EIP-1559 typed transaction signature scheme.
+// The typed envelope (type byte 0x02) replaces GasPrice with a GasFeeCap/GasTipCap
+// pair and otherwise follows the same shape as AccessListSigner. Anything other than
+// a dynamic fee transaction is delegated to fallback, the signer that was active
+// before London, since those tx types remain valid after London activates.
+type DynamicFeeSigner struct {
+	chainID  uint64
+	fallback TxSigner
+}
+
+// NewDynamicFeeSigner returns a new DynamicFeeSigner object, delegating anything that isn't
+// a dynamic fee transaction to fallback
+func NewDynamicFeeSigner(chainID uint64, fallback TxSigner) *DynamicFeeSigner {
+	return &DynamicFeeSigner{chainID: chainID, fallback: fallback}
+}
+
+// Hash returns the keccak256 hash of the EIP-2718 typed payload, used both for signing and sender recovery
+func (e *DynamicFeeSigner) Hash(tx *types.Transaction) types.Hash {
+	if tx.Type != types.DynamicFeeTxType {
+		return e.fallback.Hash(tx)
+	}
+
+	a := signerPool.Get()
+	defer signerPool.Put(a)
+
+	vv := a.NewArray()
+	vv.Set(a.NewUint(e.chainID))
+	vv.Set(a.NewUint(tx.Nonce))
+	vv.Set(a.NewBigInt(tx.GasTipCap))
+	vv.Set(a.NewBigInt(tx.GasFeeCap))
+	vv.Set(a.NewUint(tx.Gas))
+
+	if tx.To != nil {
+		vv.Set(a.NewBytes((*tx.To).Bytes()))
+	} else {
+		vv.Set(a.NewBytes(nil))
+	}
+
+	vv.Set(a.NewBigInt(tx.Value))
+	vv.Set(a.NewBytes(tx.Input))
+	vv.Set(tx.AccessList.MarshalRLPWith(a))
+
+	return typedTxHash(types.DynamicFeeTxType, vv.MarshalTo(nil))
+}
+
+// Sender returns the sender of the transaction
+func (e *DynamicFeeSigner) Sender(tx *types.Transaction) (types.Address, error) {
+	if tx.Type != types.DynamicFeeTxType {
+		return e.fallback.Sender(tx)
+	}
+
+	parity, err := validateTypedParity(tx.V)
+	if err != nil {
+		return types.Address{}, err
+	}
+
+	sig, err := encodeSignature(tx.R, tx.S, parity)
+	if err != nil {
+		return types.Address{}, err
+	}
+
+	pub, err := Ecrecover(e.Hash(tx).Bytes(), sig)
+	if err != nil {
+		return types.Address{}, err
+	}
+
+	buf := Keccak256(pub[1:])[12:]
+
+	return types.BytesToAddress(buf), nil
+}
+
+// SignTx signs the transaction using the passed in private key. tx.Type selects the
+// signature scheme; anything other than a dynamic fee transaction is delegated to fallback.
+func (e *DynamicFeeSigner) SignTx(
+	tx *types.Transaction,
+	privateKey *ecdsa.PrivateKey,
+) (*types.Transaction, error) {
+	if tx.Type != types.DynamicFeeTxType {
+		return e.fallback.SignTx(tx, privateKey)
+	}
+
+	tx = tx.Copy()
+
+	h := e.Hash(tx)
+
+	sig, err := Sign(privateKey, h[:])
+	if err != nil {
+		return nil, err
+	}
+
+	tx.R = new(big.Int).SetBytes(sig[:32])
+	tx.S = new(big.Int).SetBytes(sig[32:64])
+	tx.V = new(big.Int).SetBytes([]byte{sig[64]})
+
+	return tx, nil
+}
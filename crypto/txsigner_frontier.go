@@ -2,6 +2,7 @@ package crypto
 
 import (
 	"crypto/ecdsa"
+	"fmt"
 	"math/big"
 
 	"github.com/0xPolygon/polygon-edge/types"
@@ -26,6 +27,10 @@ func (f *FrontierSigner) Hash(tx *types.Transaction) types.Hash {
 
 // Sender decodes the signature and returns the sender of the transaction
 func (f *FrontierSigner) Sender(tx *types.Transaction) (types.Address, error) {
+	if tx.Type != types.LegacyTx {
+		return types.Address{}, fmt.Errorf("transaction type %s is not supported by the frontier signer", tx.Type)
+	}
+
 	refV := big.NewInt(0)
 	if tx.V != nil {
 		refV.SetBytes(tx.V.Bytes())
@@ -54,6 +59,7 @@ func (f *FrontierSigner) SignTx(
 	privateKey *ecdsa.PrivateKey,
 ) (*types.Transaction, error) {
 	tx = tx.Copy()
+	tx.Type = types.LegacyTx
 
 	h := f.Hash(tx)
 
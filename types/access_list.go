@@ -0,0 +1,87 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/umbracle/fastrlp"
+)
+
+// AccessList is the list of addresses and storage keys a typed transaction
+// declares it will access, as introduced by EIP-2930. Accessing anything
+// outside the list still works, it is just no longer eligible for the
+// EIP-2929 cold-access gas discount.
+type AccessList []AccessTuple
+
+// AccessTuple is a single entry of an AccessList
+type AccessTuple struct {
+	Address     Address
+	StorageKeys []Hash
+}
+
+// MarshalRLPWith marshals the access list into an RLP array value
+func (al AccessList) MarshalRLPWith(a *fastrlp.Arena) *fastrlp.Value {
+	vv := a.NewArray()
+
+	for _, tuple := range al {
+		tupleVV := a.NewArray()
+		tupleVV.Set(a.NewBytes(tuple.Address.Bytes()))
+
+		keysVV := a.NewArray()
+		for _, key := range tuple.StorageKeys {
+			keysVV.Set(a.NewBytes(key.Bytes()))
+		}
+
+		tupleVV.Set(keysVV)
+		vv.Set(tupleVV)
+	}
+
+	return vv
+}
+
+// UnmarshalRLPFrom unmarshals the access list from an RLP array value
+func (al *AccessList) UnmarshalRLPFrom(p *fastrlp.Parser, v *fastrlp.Value) error {
+	elems, err := v.GetElems()
+	if err != nil {
+		return err
+	}
+
+	result := make(AccessList, len(elems))
+
+	for i, elem := range elems {
+		tupleElems, err := elem.GetElems()
+		if err != nil {
+			return err
+		}
+
+		if len(tupleElems) != 2 {
+			return fmt.Errorf("access list tuple expected 2 elements, got %d", len(tupleElems))
+		}
+
+		addrBytes, err := tupleElems[0].GetBytes(nil)
+		if err != nil {
+			return err
+		}
+
+		result[i].Address = BytesToAddress(addrBytes)
+
+		keyElems, err := tupleElems[1].GetElems()
+		if err != nil {
+			return err
+		}
+
+		result[i].StorageKeys = make([]Hash, len(keyElems))
+
+		for j, keyElem := range keyElems {
+			keyBytes, err := keyElem.GetBytes(nil)
+			if err != nil {
+				return err
+			}
+
+			result[i].StorageKeys[j] = BytesToHash(keyBytes)
+		}
+	}
+
+	*al = result
+
+	return nil
+}
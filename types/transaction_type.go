@@ -0,0 +1,28 @@
+package types
+
+// TxType denotes the transaction envelope type, as defined by EIP-2718
+type TxType byte
+
+const (
+	// LegacyTx is the pre-EIP-2718 transaction type, carrying no type prefix on the wire
+	LegacyTx TxType = 0x0
+
+	// AccessListTxType is the EIP-2930 typed transaction, adding an access list to legacy transactions
+	AccessListTxType TxType = 0x01
+
+	// DynamicFeeTxType is the EIP-1559 typed transaction, replacing GasPrice with a fee cap and a priority tip cap
+	DynamicFeeTxType TxType = 0x02
+)
+
+func (t TxType) String() string {
+	switch t {
+	case LegacyTx:
+		return "LegacyTx"
+	case AccessListTxType:
+		return "AccessListTx"
+	case DynamicFeeTxType:
+		return "DynamicFeeTx"
+	default:
+		return "Unknown"
+	}
+}